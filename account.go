@@ -22,7 +22,7 @@ func (c Client) NewAccount(privateKey crypto.Signer, onlyReturnExisting, termsOf
 	}
 
 	account := Account{}
-	resp, err := c.post(c.dir.NewAccount, "", privateKey, newAccountReq, &account, http.StatusOK, http.StatusCreated)
+	resp, err := c.post(c.Directory.NewAccount, "", privateKey, newAccountReq, &account, http.StatusOK, http.StatusCreated)
 	if err != nil {
 		return account, err
 	}
@@ -56,13 +56,13 @@ func (c Client) NewAccountExternalBinding(privateKey crypto.Signer, onlyReturnEx
 
 	account := Account{}
 
-	jwsEab, err := jwsEncodeEAB(privateKey, keyID(eab.KeyIdentifier), eab.MacKey, c.dir.NewAccount, eab.HashFunc)
+	jwsEab, err := jwsEncodeEAB(privateKey, keyID(eab.KeyIdentifier), eab.MacKey, c.Directory.NewAccount, eab.HashFunc)
 	if err != nil {
 		return account, fmt.Errorf("acme: error computing external account binding jws: %v", err)
 	}
 	newAccountReq.ExternalAccountBinding = jwsEab
 
-	resp, err := c.post(c.dir.NewAccount, "", privateKey, newAccountReq, &account, http.StatusOK, http.StatusCreated)
+	resp, err := c.post(c.Directory.NewAccount, "", privateKey, newAccountReq, &account, http.StatusOK, http.StatusCreated)
 	if err != nil {
 		return account, err
 	}
@@ -129,12 +129,12 @@ func (c Client) AccountKeyChange(account Account, newPrivateKey crypto.Signer) (
 		OldKey:  []byte(oldJwkKeyPub),
 	}
 
-	innerJws, err := jwsEncodeJSON(keyChangeReq, newPrivateKey, "", "", c.dir.KeyChange)
+	innerJws, err := jwsEncodeJSON(keyChangeReq, newPrivateKey, "", "", c.Directory.KeyChange)
 	if err != nil {
 		return account, fmt.Errorf("acme: error encoding inner jws: %v", err)
 	}
 
-	if _, err := c.post(c.dir.KeyChange, account.URL, account.PrivateKey, json.RawMessage(innerJws), nil, http.StatusOK); err != nil {
+	if _, err := c.post(c.Directory.KeyChange, account.URL, account.PrivateKey, json.RawMessage(innerJws), nil, http.StatusOK); err != nil {
 		return account, err
 	}
 
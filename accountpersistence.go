@@ -0,0 +1,138 @@
+package acme
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+)
+
+// accountJSON is the on-disk representation of an Account, encoding its private key as a
+// PKCS#8-in-PEM block. It is used only by Account.Save and Client.LoadAccount - Account itself
+// has no custom Marshaler/Unmarshaler, since that would also hijack decoding of the plain acme
+// server responses (which have no private key) handled elsewhere via c.post.
+type accountJSON struct {
+	PrivateKey             string   `json:"privateKey"`
+	URL                    string   `json:"url"`
+	Status                 string   `json:"status,omitempty"`
+	Contact                []string `json:"contact,omitempty"`
+	Orders                 string   `json:"orders,omitempty"`
+	Thumbprint             string   `json:"thumbprint,omitempty"`
+	ExternalAccountBinding *struct {
+		KeyIdentifier string `json:"keyIdentifier"`
+		MacKey        string `json:"macKey"`
+		HashFunc      uint   `json:"hashFunc"`
+	} `json:"externalAccountBinding,omitempty"`
+}
+
+// toAccountJSON converts account into its on-disk representation, PEM-encoding its private key.
+func toAccountJSON(account Account) (accountJSON, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(account.PrivateKey)
+	if err != nil {
+		return accountJSON{}, fmt.Errorf("acme: error marshalling account private key: %v", err)
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+	out := accountJSON{
+		PrivateKey: string(keyPEM),
+		URL:        account.URL,
+		Status:     account.Status,
+		Contact:    account.Contact,
+		Orders:     account.Orders,
+		Thumbprint: account.Thumbprint,
+	}
+
+	if account.ExternalAccountBinding.KeyIdentifier != "" {
+		out.ExternalAccountBinding = &struct {
+			KeyIdentifier string `json:"keyIdentifier"`
+			MacKey        string `json:"macKey"`
+			HashFunc      uint   `json:"hashFunc"`
+		}{
+			KeyIdentifier: account.ExternalAccountBinding.KeyIdentifier,
+			MacKey:        base64.RawURLEncoding.EncodeToString(account.ExternalAccountBinding.MacKey),
+			HashFunc:      uint(account.ExternalAccountBinding.HashFunc),
+		}
+	}
+
+	return out, nil
+}
+
+// fromAccountJSON converts in back into an Account, parsing the PKCS#8-in-PEM private key back
+// into a crypto.Signer.
+func fromAccountJSON(in accountJSON) (Account, error) {
+	var account Account
+
+	block, _ := pem.Decode([]byte(in.PrivateKey))
+	if block == nil {
+		return account, fmt.Errorf("acme: error decoding account private key: no PEM block found")
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return account, fmt.Errorf("acme: error parsing account private key: %v", err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return account, fmt.Errorf("acme: account private key of type %T does not implement crypto.Signer", key)
+	}
+
+	account.PrivateKey = signer
+	account.URL = in.URL
+	account.Status = in.Status
+	account.Contact = in.Contact
+	account.Orders = in.Orders
+	account.Thumbprint = in.Thumbprint
+
+	if in.ExternalAccountBinding != nil {
+		macKey, err := base64.RawURLEncoding.DecodeString(in.ExternalAccountBinding.MacKey)
+		if err != nil {
+			return account, fmt.Errorf("acme: error decoding eab mac key: %v", err)
+		}
+		account.ExternalAccountBinding = ExternalAccountBinding{
+			KeyIdentifier: in.ExternalAccountBinding.KeyIdentifier,
+			MacKey:        macKey,
+			HashFunc:      crypto.Hash(in.ExternalAccountBinding.HashFunc),
+		}
+	}
+
+	return account, nil
+}
+
+// Save writes account to w in the format read back by Client.LoadAccount.
+func (a Account) Save(w io.Writer) error {
+	out, err := toAccountJSON(a)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(out)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(data)
+
+	return err
+}
+
+// LoadAccount reads an Account previously written with Account.Save.
+func (c Client) LoadAccount(r io.Reader) (Account, error) {
+	var in accountJSON
+	if err := json.NewDecoder(r).Decode(&in); err != nil {
+		return Account{}, fmt.Errorf("acme: error decoding account: %v", err)
+	}
+
+	return fromAccountJSON(in)
+}
+
+// FetchAccount rehydrates an Account (recovering its URL, Orders and Status) from just a
+// private key, using onlyReturnExisting=true so it fails rather than registering a new account
+// if the key isn't already known to the server. This lets long-running services restart
+// without re-registering or inventing their own account serialization.
+func (c Client) FetchAccount(privateKey crypto.Signer) (Account, error) {
+	return c.NewAccount(privateKey, true, false)
+}
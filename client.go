@@ -0,0 +1,101 @@
+package acme
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Client is an acme client session, wrapping an http.Client and the service directory
+// discovered from the acme directory url it was created with.
+type Client struct {
+	http.Client
+	Directory Directory
+	noncePool NoncePool
+}
+
+// NewClient creates a new acme client given a directory url, fetching and caching the
+// service directory.
+func NewClient(directoryURL string) (Client, error) {
+	c := Client{}
+
+	resp, err := c.Get(directoryURL)
+	if err != nil {
+		return c, fmt.Errorf("acme: error fetching directory: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if err := checkError(resp, http.StatusOK); err != nil {
+		return c, err
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&c.Directory); err != nil {
+		return c, fmt.Errorf("acme: error decoding directory: %v", err)
+	}
+
+	c.noncePool = NewNoncePool(&c.Client, c.Directory.NewNonce, defaultNoncePoolSize)
+
+	return c, nil
+}
+
+// WithNoncePool returns a copy of c that draws replay-nonces from pool instead of the default
+// in-memory pool. Callers doing bulk issuance across many goroutines can construct a single
+// pool and share it across clients to avoid a round-trip-per-request HEAD to newNonce.
+func (c Client) WithNoncePool(pool NoncePool) Client {
+	c.noncePool = pool
+	return c
+}
+
+// post signs payload with privateKey (using kid for the jws header when provided, or the
+// jwk form otherwise) and posts it to url, decoding the response into out when non-nil and
+// verifying the response status matches one of expectedStatuses. If the server rejects the
+// nonce with badNonce, the request is retried once with a freshly fetched nonce.
+func (c Client) post(url, kid string, privateKey crypto.Signer, payload interface{}, out interface{}, expectedStatuses ...int) (*http.Response, error) {
+	resp, err := c.postOnce(url, kid, privateKey, payload, out, expectedStatuses...)
+	if errors.Is(err, ErrBadNonce) {
+		resp, err = c.postOnce(url, kid, privateKey, payload, out, expectedStatuses...)
+	}
+
+	return resp, err
+}
+
+func (c Client) postOnce(url, kid string, privateKey crypto.Signer, payload interface{}, out interface{}, expectedStatuses ...int) (*http.Response, error) {
+	nonce, err := c.noncePool.Get(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("acme: error marshalling request payload: %v", err)
+	}
+
+	jws, err := jwsEncodeJSON(json.RawMessage(body), privateKey, kid, nonce, url)
+	if err != nil {
+		return nil, fmt.Errorf("acme: error encoding jws: %v", err)
+	}
+
+	resp, err := c.Post(url, "application/jose+json", bytes.NewReader(jws))
+	if err != nil {
+		return nil, fmt.Errorf("acme: error posting to %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+
+	c.noncePool.Put(resp.Header.Get("Replay-Nonce"))
+
+	if err := checkError(resp, expectedStatuses...); err != nil {
+		return resp, err
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return resp, fmt.Errorf("acme: error decoding response: %v", err)
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,24 @@
+package acme
+
+// Directory represents an acme directory resource, listing the urls of the various
+// endpoints offered by the service along with some service metadata.
+// https://tools.ietf.org/html/rfc8555#section-7.1.1
+type Directory struct {
+	NewNonce    string `json:"newNonce"`
+	NewAccount  string `json:"newAccount"`
+	NewOrder    string `json:"newOrder"`
+	NewAuthz    string `json:"newAuthz"`
+	RevokeCert  string `json:"revokeCert"`
+	KeyChange   string `json:"keyChange"`
+	RenewalInfo string `json:"renewalInfo"`
+	Meta        Meta   `json:"meta"`
+}
+
+// Meta holds additional directory metadata as defined by
+// https://tools.ietf.org/html/rfc8555#section-7.1.1
+type Meta struct {
+	TermsOfService          string   `json:"termsOfService"`
+	Website                 string   `json:"website"`
+	CaaIdentities           []string `json:"caaIdentities"`
+	ExternalAccountRequired bool     `json:"externalAccountRequired"`
+}
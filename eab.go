@@ -0,0 +1,59 @@
+package acme
+
+import (
+	"crypto"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+)
+
+// eabKeySizesBits are the MAC key sizes valid for HS256, HS384 and HS512 respectively.
+var eabKeySizesBits = map[int]bool{256: true, 384: true, 512: true}
+
+// GenerateEABCredentials generates a random key identifier and an HS256/HS384/HS512-appropriate
+// MAC key of the given size in bits (256, 384 or 512), both base64url-encoded, suitable for use
+// with NewAccountExternalBinding. This is primarily useful for acme servers that issue their own
+// EAB credentials out of band but let callers provision them (eg a private CA's admin API).
+func GenerateEABCredentials(bits int) (kid string, macKeyB64 string, err error) {
+	if !eabKeySizesBits[bits] {
+		return "", "", fmt.Errorf("acme: eab mac key size must be 256, 384 or 512 bits (HS256/HS384/HS512), got %d", bits)
+	}
+
+	kidBytes := make([]byte, 16)
+	if _, err := rand.Read(kidBytes); err != nil {
+		return "", "", fmt.Errorf("acme: error generating eab key identifier: %v", err)
+	}
+
+	macKey := make([]byte, bits/8)
+	if _, err := rand.Read(macKey); err != nil {
+		return "", "", fmt.Errorf("acme: error generating eab mac key: %v", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(kidBytes), base64.RawURLEncoding.EncodeToString(macKey), nil
+}
+
+// ParseEABCredentials decodes a kid and base64url-encoded MAC key received out of band from an
+// acme service (eg ZeroSSL, Google Trust Services) into an ExternalAccountBinding, validating
+// that the MAC key is the correct length for hash.
+func ParseEABCredentials(kid, macKeyB64 string, hash crypto.Hash) (ExternalAccountBinding, error) {
+	macKey, err := base64.RawURLEncoding.DecodeString(macKeyB64)
+	if err != nil {
+		return ExternalAccountBinding{}, fmt.Errorf("acme: error decoding eab mac key: %v", err)
+	}
+
+	if len(macKey) != hash.Size() {
+		return ExternalAccountBinding{}, fmt.Errorf("acme: eab mac key is %d bytes, expected %d for %v", len(macKey), hash.Size(), hash)
+	}
+
+	return ExternalAccountBinding{
+		KeyIdentifier: kid,
+		MacKey:        macKey,
+		HashFunc:      hash,
+	}, nil
+}
+
+// SupportsEAB reports whether the acme service requires external account binding for new
+// accounts, as advertised by the directory's meta.externalAccountRequired field.
+func (c Client) SupportsEAB() bool {
+	return c.Directory.Meta.ExternalAccountRequired
+}
@@ -0,0 +1,72 @@
+package acme
+
+import (
+	"crypto"
+	"encoding/base64"
+	"testing"
+)
+
+func TestGenerateEABCredentials(t *testing.T) {
+	tests := []struct {
+		Name      string
+		Bits      int
+		Hash      crypto.Hash
+		ExpectErr bool
+	}{
+		{Name: "HS256 size", Bits: 256, Hash: crypto.SHA256},
+		{Name: "HS384 size", Bits: 384, Hash: crypto.SHA384},
+		{Name: "HS512 size", Bits: 512, Hash: crypto.SHA512},
+		{Name: "too short", Bits: 8, ExpectErr: true},
+		{Name: "not a supported size", Bits: 160, ExpectErr: true},
+	}
+
+	for _, currentTest := range tests {
+		kid, macKeyB64, err := GenerateEABCredentials(currentTest.Bits)
+		if currentTest.ExpectErr {
+			if err == nil {
+				t.Fatalf("%s: expected error, got none", currentTest.Name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", currentTest.Name, err)
+		}
+		if kid == "" {
+			t.Fatalf("%s: expected a non-empty key identifier", currentTest.Name)
+		}
+
+		macKey, err := base64.RawURLEncoding.DecodeString(macKeyB64)
+		if err != nil {
+			t.Fatalf("%s: error decoding mac key: %v", currentTest.Name, err)
+		}
+		if len(macKey) != currentTest.Hash.Size() {
+			t.Fatalf("%s: expected mac key of %d bytes, got %d", currentTest.Name, currentTest.Hash.Size(), len(macKey))
+		}
+	}
+}
+
+func TestParseEABCredentials(t *testing.T) {
+	kid, macKeyB64, err := GenerateEABCredentials(256)
+	if err != nil {
+		t.Fatalf("error generating eab credentials: %v", err)
+	}
+
+	eab, err := ParseEABCredentials(kid, macKeyB64, crypto.SHA256)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if eab.KeyIdentifier != kid {
+		t.Fatalf("expected key identifier %q, got %q", kid, eab.KeyIdentifier)
+	}
+	if len(eab.MacKey) != crypto.SHA256.Size() {
+		t.Fatalf("expected mac key of %d bytes, got %d", crypto.SHA256.Size(), len(eab.MacKey))
+	}
+
+	if _, err := ParseEABCredentials(kid, macKeyB64, crypto.SHA384); err == nil {
+		t.Fatal("expected error when mac key length doesn't match the given hash, got none")
+	}
+
+	if _, err := ParseEABCredentials(kid, "not valid base64url!", crypto.SHA256); err == nil {
+		t.Fatal("expected error for unparsable mac key, got none")
+	}
+}
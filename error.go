@@ -0,0 +1,110 @@
+package acme
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Sentinel errors for the acme error namespace defined by RFC 8555 §6.7 and the extensions
+// used by common acme services. AcmeError.Is allows callers to test a returned error with
+// errors.Is(err, acme.ErrBadNonce) and friends without inspecting the raw problem type string.
+var (
+	ErrBadNonce                = errors.New("acme: bad nonce")
+	ErrRateLimited             = errors.New("acme: rate limited")
+	ErrUserActionRequired      = errors.New("acme: user action required")
+	ErrExternalAccountRequired = errors.New("acme: external account binding required")
+	ErrCAA                     = errors.New("acme: caa check failed")
+	ErrDNS                     = errors.New("acme: dns problem")
+	ErrConnection              = errors.New("acme: connection problem")
+	ErrTLS                     = errors.New("acme: tls problem")
+	ErrIncorrectResponse       = errors.New("acme: incorrect response")
+	ErrRejectedIdentifier      = errors.New("acme: rejected identifier")
+	ErrOrderNotReady           = errors.New("acme: order not ready")
+	ErrCompound                = errors.New("acme: compound error")
+	ErrAlreadyRevoked          = errors.New("acme: certificate already revoked")
+	ErrBadRevocationReason     = errors.New("acme: server rejected revocation reason")
+)
+
+// acmeErrorSentinels maps the acme problem type URN (minus the "urn:ietf:params:acme:error:"
+// prefix) to the sentinel error callers can match against with errors.Is.
+var acmeErrorSentinels = map[string]error{
+	"badNonce":                ErrBadNonce,
+	"rateLimited":             ErrRateLimited,
+	"userActionRequired":      ErrUserActionRequired,
+	"externalAccountRequired": ErrExternalAccountRequired,
+	"caa":                     ErrCAA,
+	"dns":                     ErrDNS,
+	"connection":              ErrConnection,
+	"tls":                     ErrTLS,
+	"incorrectResponse":       ErrIncorrectResponse,
+	"rejectedIdentifier":      ErrRejectedIdentifier,
+	"orderNotReady":           ErrOrderNotReady,
+	"compound":                ErrCompound,
+	"alreadyRevoked":          ErrAlreadyRevoked,
+	"badRevocationReason":     ErrBadRevocationReason,
+}
+
+const acmeErrorTypePrefix = "urn:ietf:params:acme:error:"
+
+// Identifier identifies the subject (eg a DNS name) a SubProblem applies to.
+type Identifier struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// SubProblem is a single entry in an RFC 8555 problem document's "subproblems" array,
+// typically one per identifier in a multi-identifier order that failed.
+type SubProblem struct {
+	Type       string     `json:"type"`
+	Detail     string     `json:"detail"`
+	Identifier Identifier `json:"identifier"`
+}
+
+// AcmeError represents an RFC 8555 problem document returned by the acme service.
+type AcmeError struct {
+	StatusCode  int          `json:"-"`
+	Type        string       `json:"type"`
+	Detail      string       `json:"detail"`
+	Subproblems []SubProblem `json:"subproblems,omitempty"`
+	RetryAfter  time.Time    `json:"-"`
+}
+
+// Error implements the error interface for AcmeError.
+func (a AcmeError) Error() string {
+	return fmt.Sprintf("acme: error code %d %q: %s", a.StatusCode, a.Type, a.Detail)
+}
+
+// Is allows errors.Is(err, acme.ErrBadNonce) and similar to match against the acme problem
+// type this error was constructed from.
+func (a AcmeError) Is(target error) bool {
+	sentinel, ok := acmeErrorSentinels[strings.TrimPrefix(a.Type, acmeErrorTypePrefix)]
+	return ok && sentinel == target
+}
+
+// checkError inspects resp and returns nil if its status code matches one of expectedStatuses,
+// otherwise it attempts to decode an acme problem document from the body and returns it as an
+// AcmeError (falling back to a generic error if the body isn't a valid problem document). For
+// 429 and 503 responses, the parsed Retry-After header (HTTP-date or delta-seconds) is attached
+// to the returned AcmeError.
+func checkError(resp *http.Response, expectedStatuses ...int) error {
+	for _, s := range expectedStatuses {
+		if resp.StatusCode == s {
+			return nil
+		}
+	}
+
+	acmeError := AcmeError{StatusCode: resp.StatusCode}
+	if err := json.NewDecoder(resp.Body).Decode(&acmeError); err != nil {
+		return fmt.Errorf("acme: error code %d, unable to decode error body: %v", resp.StatusCode, err)
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		acmeError.RetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+	}
+
+	return acmeError
+}
@@ -0,0 +1,117 @@
+package acme
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// defaultNoncePoolSize is the default high-water mark for the in-memory NoncePool.
+const defaultNoncePoolSize = 8
+
+// NoncePool supplies replay-nonces for signed acme requests. Implementations are expected to be
+// safe for concurrent use, since a pool is typically shared across goroutines doing bulk
+// issuance to avoid a round-trip-per-request HEAD to newNonce.
+type NoncePool interface {
+	// Get returns a nonce, fetching one from the server if none are cached.
+	Get(ctx context.Context) (string, error)
+	// Put returns a nonce (eg one harvested from a Replay-Nonce response header) to the pool.
+	Put(nonce string)
+}
+
+// inMemoryNoncePool is the default NoncePool implementation. It keeps up to size nonces cached,
+// harvested from response headers or prefetched via HEAD requests to newNonceURL, refilling
+// asynchronously as nonces are taken so callers rarely block on a round trip.
+type inMemoryNoncePool struct {
+	client      *http.Client
+	newNonceURL string
+	size        int
+
+	mu     sync.Mutex
+	nonces []string
+}
+
+// NewNoncePool creates a NoncePool that prefetches and caches up to size nonces from
+// newNonceURL using client.
+func NewNoncePool(client *http.Client, newNonceURL string, size int) NoncePool {
+	if size <= 0 {
+		size = defaultNoncePoolSize
+	}
+	return &inMemoryNoncePool{
+		client:      client,
+		newNonceURL: newNonceURL,
+		size:        size,
+	}
+}
+
+// Get returns a cached nonce if one is available, kicking off an asynchronous refill, otherwise
+// it fetches one synchronously.
+func (p *inMemoryNoncePool) Get(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	if n := len(p.nonces); n > 0 {
+		nonce := p.nonces[n-1]
+		p.nonces = p.nonces[:n-1]
+		p.mu.Unlock()
+		go p.refill()
+		return nonce, nil
+	}
+	p.mu.Unlock()
+
+	return p.fetch(ctx)
+}
+
+// Put adds nonce to the pool, dropping it if the pool is already at its high-water mark.
+func (p *inMemoryNoncePool) Put(nonce string) {
+	if nonce == "" {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.nonces) >= p.size {
+		return
+	}
+	p.nonces = append(p.nonces, nonce)
+}
+
+// refill tops the pool back up to its high-water mark, fetching nonces one at a time. It gives
+// up silently on error since Get will fall back to fetching synchronously if the pool is empty.
+func (p *inMemoryNoncePool) refill() {
+	for {
+		p.mu.Lock()
+		need := p.size - len(p.nonces)
+		p.mu.Unlock()
+		if need <= 0 {
+			return
+		}
+
+		nonce, err := p.fetch(context.Background())
+		if err != nil {
+			return
+		}
+		p.Put(nonce)
+	}
+}
+
+// fetch performs a single HEAD request against newNonceURL and returns the Replay-Nonce header.
+func (p *inMemoryNoncePool) fetch(ctx context.Context) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, p.newNonceURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("acme: error building nonce request: %v", err)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("acme: error fetching nonce: %v", err)
+	}
+	defer resp.Body.Close()
+
+	nonce := resp.Header.Get("Replay-Nonce")
+	if nonce == "" {
+		return "", fmt.Errorf("acme: no nonce returned from %s", p.newNonceURL)
+	}
+
+	return nonce, nil
+}
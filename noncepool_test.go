@@ -0,0 +1,97 @@
+package acme
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestInMemoryNoncePoolPut(t *testing.T) {
+	pool := NewNoncePool(http.DefaultClient, "", 2)
+
+	pool.Put("a")
+	pool.Put("b")
+	pool.Put("c") // dropped, pool is already at its high-water mark
+	pool.Put("")  // ignored
+
+	p := pool.(*inMemoryNoncePool)
+	p.mu.Lock()
+	got := len(p.nonces)
+	p.mu.Unlock()
+
+	if got != 2 {
+		t.Fatalf("expected pool to cap at 2 nonces, got %d", got)
+	}
+}
+
+func TestInMemoryNoncePoolGetUsesCachedNonce(t *testing.T) {
+	pool := NewNoncePool(http.DefaultClient, "", 8)
+	pool.Put("cached-nonce")
+
+	nonce, err := pool.Get(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nonce != "cached-nonce" {
+		t.Fatalf("expected cached nonce, got %q", nonce)
+	}
+}
+
+func TestInMemoryNoncePoolGetFetchesWhenEmpty(t *testing.T) {
+	var served int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt64(&served, 1)
+		w.Header().Set("Replay-Nonce", "nonce-"+strconv.FormatInt(n, 10))
+	}))
+	defer server.Close()
+
+	pool := NewNoncePool(server.Client(), server.URL, 8)
+
+	nonce, err := pool.Get(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nonce == "" {
+		t.Fatal("expected a non-empty nonce")
+	}
+}
+
+func TestInMemoryNoncePoolConcurrentGetPut(t *testing.T) {
+	var served int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt64(&served, 1)
+		w.Header().Set("Replay-Nonce", "nonce-"+strconv.FormatInt(n, 10))
+	}))
+	defer server.Close()
+
+	pool := NewNoncePool(server.Client(), server.URL, 8)
+
+	const goroutines = 50
+	var wg sync.WaitGroup
+	errs := make(chan error, goroutines)
+
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			nonce, err := pool.Get(context.Background())
+			if err != nil {
+				errs <- fmt.Errorf("get: %v", err)
+				return
+			}
+			pool.Put(nonce)
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+}
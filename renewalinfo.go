@@ -0,0 +1,138 @@
+package acme
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ErrRenewalInfoUnsupported is returned by GetRenewalInfo and UpdateRenewalInfo when the
+// directory does not advertise a renewalInfo endpoint.
+var ErrRenewalInfoUnsupported = errors.New("acme: server does not support renewal info (ARI)")
+
+// RenewalInfo is the ACME Renewal Information (ARI) resource for a certificate, as defined by
+// draft-ietf-acme-ari.
+type RenewalInfo struct {
+	SuggestedWindow struct {
+		Start time.Time `json:"start"`
+		End   time.Time `json:"end"`
+	} `json:"suggestedWindow"`
+	ExplanationURL string `json:"explanationURL"`
+}
+
+// SuggestedTime returns a uniformly random time within the suggested renewal window, suitable
+// for scheduling the actual renewal attempt so that clients don't all renew at once.
+func (r RenewalInfo) SuggestedTime() time.Time {
+	start := r.SuggestedWindow.Start
+	window := r.SuggestedWindow.End.Sub(start)
+	if window <= 0 {
+		return start
+	}
+	return start.Add(time.Duration(rand.Int63n(int64(window))))
+}
+
+// GetRenewalInfo fetches the ACME Renewal Information for cert, returning the server's
+// suggested renewal window along with the time, parsed from any Retry-After header, before
+// which the caller should not check again (the zero time if the header is absent or unparsable).
+func (c Client) GetRenewalInfo(cert *x509.Certificate) (RenewalInfo, time.Time, error) {
+	var renewalInfo RenewalInfo
+
+	if c.Directory.RenewalInfo == "" {
+		return renewalInfo, time.Time{}, ErrRenewalInfoUnsupported
+	}
+
+	id, err := ariCertID(cert)
+	if err != nil {
+		return renewalInfo, time.Time{}, err
+	}
+
+	resp, err := c.Get(c.Directory.RenewalInfo + "/" + id)
+	if err != nil {
+		return renewalInfo, time.Time{}, fmt.Errorf("acme: error fetching renewal info: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if err := checkError(resp, http.StatusOK); err != nil {
+		return renewalInfo, time.Time{}, err
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&renewalInfo); err != nil {
+		return renewalInfo, time.Time{}, fmt.Errorf("acme: error decoding renewal info: %v", err)
+	}
+
+	return renewalInfo, parseRetryAfter(resp.Header.Get("Retry-After")), nil
+}
+
+// UpdateRenewalInfo tells the acme service whether cert has been replaced, as given by
+// replaced; once told it has been, the service can stop suggesting a renewal window for it.
+func (c Client) UpdateRenewalInfo(account Account, cert *x509.Certificate, replaced bool) error {
+	if c.Directory.RenewalInfo == "" {
+		return ErrRenewalInfoUnsupported
+	}
+
+	id, err := ariCertID(cert)
+	if err != nil {
+		return err
+	}
+
+	updateReq := struct {
+		CertID   string `json:"certID"`
+		Replaced bool   `json:"replaced"`
+	}{
+		CertID:   id,
+		Replaced: replaced,
+	}
+
+	_, err = c.post(c.Directory.RenewalInfo, account.URL, account.PrivateKey, updateReq, nil, http.StatusOK)
+
+	return err
+}
+
+// ariCertID computes the ARI unique certificate identifier for cert as specified by
+// draft-ietf-acme-ari: base64url(AKI keyIdentifier) + "." + base64url(serial).
+func ariCertID(cert *x509.Certificate) (string, error) {
+	if len(cert.AuthorityKeyId) == 0 {
+		return "", errors.New("acme: certificate has no authority key identifier")
+	}
+
+	akiB64 := base64.RawURLEncoding.EncodeToString(cert.AuthorityKeyId)
+	serialB64 := base64.RawURLEncoding.EncodeToString(serialDERContent(cert.SerialNumber))
+
+	return akiB64 + "." + serialB64, nil
+}
+
+// serialDERContent returns the content octets of the DER INTEGER encoding of serial: its
+// minimal big-endian two's-complement representation, which for a positive integer whose
+// highest bit is set requires a leading 0x00 that big.Int.Bytes() does not include.
+func serialDERContent(serial *big.Int) []byte {
+	b := serial.Bytes()
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		b = append([]byte{0x00}, b...)
+	}
+	return b
+}
+
+// parseRetryAfter parses a Retry-After header value that may be either an HTTP-date or a
+// delta-seconds integer, returning the zero time if it's empty or unparsable.
+func parseRetryAfter(value string) time.Time {
+	if value == "" {
+		return time.Time{}
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Now().Add(time.Duration(seconds) * time.Second)
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		return t
+	}
+
+	return time.Time{}
+}
@@ -0,0 +1,78 @@
+package acme
+
+import (
+	"crypto/x509"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestAriCertID(t *testing.T) {
+	aki := []byte{0x01, 0x02, 0x03, 0x04}
+	akiB64 := "AQIDBA"
+
+	tests := []struct {
+		Name     string
+		Serial   *big.Int
+		Expected string
+	}{
+		{
+			Name:     "serial without high bit set",
+			Serial:   new(big.Int).SetBytes([]byte{0x01, 0x02, 0x03}),
+			Expected: akiB64 + ".AQID",
+		},
+		{
+			Name:     "serial with high bit set requires leading zero octet",
+			Serial:   new(big.Int).SetBytes([]byte{0x80, 0x01, 0x02}),
+			Expected: akiB64 + ".AIABAg",
+		},
+	}
+
+	for _, currentTest := range tests {
+		cert := &x509.Certificate{
+			AuthorityKeyId: aki,
+			SerialNumber:   currentTest.Serial,
+		}
+
+		id, err := ariCertID(cert)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", currentTest.Name, err)
+		}
+		if id != currentTest.Expected {
+			t.Fatalf("%s: expected %q, got %q", currentTest.Name, currentTest.Expected, id)
+		}
+	}
+}
+
+func TestAriCertIDNoAuthorityKeyId(t *testing.T) {
+	cert := &x509.Certificate{SerialNumber: big.NewInt(1)}
+
+	if _, err := ariCertID(cert); err == nil {
+		t.Fatal("expected error for certificate with no authority key identifier, got none")
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if got := parseRetryAfter(""); !got.IsZero() {
+		t.Fatalf("expected zero time for empty value, got %v", got)
+	}
+
+	before := time.Now()
+	got := parseRetryAfter("120")
+	if diff := got.Sub(before) - 120*time.Second; diff < 0 || diff > time.Second {
+		t.Fatalf("expected delta-seconds to parse to ~120s from now, got %v", got)
+	}
+
+	httpDate := "Fri, 31 Dec 1999 23:59:59 GMT"
+	want, err := time.Parse(time.RFC1123, httpDate)
+	if err != nil {
+		t.Fatalf("error parsing expected http-date: %v", err)
+	}
+	if got := parseRetryAfter(httpDate); !got.Equal(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+
+	if got := parseRetryAfter("not a valid retry-after value"); !got.IsZero() {
+		t.Fatalf("expected zero time for unparsable value, got %v", got)
+	}
+}
@@ -0,0 +1,47 @@
+package acme
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"net/http"
+)
+
+// CRLReason is a certificate revocation reason code as defined by RFC 5280 §5.3.1.
+type CRLReason int
+
+// CRLReason values supported by the acme RevokeCert endpoint.
+const (
+	CRLReasonUnspecified          CRLReason = 0
+	CRLReasonKeyCompromise        CRLReason = 1
+	CRLReasonCACompromise         CRLReason = 2
+	CRLReasonAffiliationChanged   CRLReason = 3
+	CRLReasonSuperseded           CRLReason = 4
+	CRLReasonCessationOfOperation CRLReason = 5
+	CRLReasonCertificateHold      CRLReason = 6
+	CRLReasonRemoveFromCRL        CRLReason = 8
+	CRLReasonPrivilegeWithdrawn   CRLReason = 9
+	CRLReasonAACompromise         CRLReason = 10
+)
+
+// RevokeCertificate revokes cert for the given reason, implementing RFC 8555 §7.6. The
+// returned error can be tested with errors.Is(err, acme.ErrAlreadyRevoked) or
+// errors.Is(err, acme.ErrBadRevocationReason).
+//
+// Pass a non-empty account with account.PrivateKey as key to sign the request with the
+// account's key (KID form). To revoke a certificate for an account that has been lost, pass a
+// zero-value Account and the certificate's own private key as key instead - the server accepts
+// this JWK-signed form provided key matches the certificate's public key.
+func (c Client) RevokeCertificate(account Account, cert *x509.Certificate, key crypto.Signer, reason CRLReason) error {
+	revokeReq := struct {
+		Certificate string    `json:"certificate"`
+		Reason      CRLReason `json:"reason"`
+	}{
+		Certificate: base64.RawURLEncoding.EncodeToString(cert.Raw),
+		Reason:      reason,
+	}
+
+	_, err := c.post(c.Directory.RevokeCert, account.URL, key, revokeReq, nil, http.StatusOK)
+
+	return err
+}
@@ -0,0 +1,28 @@
+package acme
+
+import "crypto"
+
+// Account represents a local representation of an account with the acme service
+type Account struct {
+	PrivateKey             crypto.Signer
+	URL                    string
+	Status                 string
+	Contact                []string
+	Orders                 string
+	Thumbprint             string
+	ExternalAccountBinding ExternalAccountBinding
+}
+
+// OrderList is a list of order urls for a given account, typically fetched from Account.Orders
+type OrderList struct {
+	Orders []string `json:"orders"`
+}
+
+// ExternalAccountBinding holds the key material a caller already received out-of-band
+// from an acme service (eg ZeroSSL, Google Trust Services) that requires binding a new
+// acme account to an existing one via https://tools.ietf.org/html/rfc8555#section-7.3.4
+type ExternalAccountBinding struct {
+	KeyIdentifier string
+	MacKey        []byte
+	HashFunc      crypto.Hash
+}